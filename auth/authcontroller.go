@@ -1,52 +1,247 @@
 package auth
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/Domains18/SchoolIt/storage"
 	"github.com/dgrijalva/jwt-go"
 	"time"
 )
 
-var jwtKey = []byte("secret")
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// Authenticator issues, validates, and rotates the JWTs and refresh tokens
+// for a single signing secret and storage.Store. It must be constructed
+// once at startup via New and passed to callers explicitly; it replaces the
+// old package-level jwtKey literal that every caller shared.
+type Authenticator struct {
+	jwtKey          []byte
+	store           storage.Store
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+// New builds an Authenticator for the given signing secret, store, and token
+// lifetimes. A zero TTL falls back to the package default.
+func New(secret []byte, store storage.Store, accessTTL, refreshTTL time.Duration) *Authenticator {
+	a := &Authenticator{
+		jwtKey:          secret,
+		store:           store,
+		accessTokenTTL:  defaultAccessTokenTTL,
+		refreshTokenTTL: defaultRefreshTokenTTL,
+	}
+	if accessTTL > 0 {
+		a.accessTokenTTL = accessTTL
+	}
+	if refreshTTL > 0 {
+		a.refreshTokenTTL = refreshTTL
+	}
+	return a
+}
 
-type jwtClaims struct {
+type Claims struct {
 	Username string `json:"username"`
-	Email string `json:"email"`
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+	UserID   uint   `json:"userId"`
 	jwt.StandardClaims
 }
 
-func GenerateJWT(email string, username string) (tokenString string, err error) {
-	expirationTime := time.Now().Add(1 * time.Hour)
-	claims:= &jwtClaims{
-		Email: email,
+// TokenPair is what callers get back from a login or a refresh: a short-lived
+// access JWT plus the opaque refresh token that can mint the next one.
+type TokenPair struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+func (a *Authenticator) GenerateJWT(email string, username string) (tokenString string, err error) {
+	user, err := a.store.Users().FindByEmail(context.Background(), email)
+	if err != nil {
+		return
+	}
+
+	expirationTime := time.Now().Add(a.accessTokenTTL)
+	jti, err := newOpaqueToken()
+	if err != nil {
+		return
+	}
+	claims := &Claims{
+		Email:    email,
 		Username: username,
+		Role:     user.Role,
+		UserID:   user.ID,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: expirationTime.Unix(),
+			Id:        jti,
 		},
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err = token.SignedString(jwtKey)
+	tokenString, err = token.SignedString(a.jwtKey)
 	return
 }
 
-func ValidateTokens(signedToken string) (err error){
+// ValidateTokens checks the token's signature, expiry and revocation status,
+// returning the parsed claims so callers (e.g. the Auth middleware) can make
+// authorization decisions without re-parsing the token themselves.
+func (a *Authenticator) ValidateTokens(signedToken string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(
-			signedToken,
-			&jwtClaims{},
-			func(token *jwt.Token) (interface{}, error){
-				return []byte(jwtKey), nil
-			},
-		)
+		signedToken,
+		&Claims{},
+		func(token *jwt.Token) (interface{}, error) {
+			return a.jwtKey, nil
+		},
+	)
 	if err != nil {
-		return
+		return nil, err
 	}
-	claims, ok := token.Claims.(*jwtClaims)
+	claims, ok := token.Claims.(*Claims)
 	if !ok {
-		err = errors.New("could not parse claims")
-		return
+		return nil, errors.New("could not parse claims")
 	}
-	if claims.ExpiresAt<time.Now().Local().Unix(){
-		err = errors.New("token expired")
-		return
+	if claims.ExpiresAt < time.Now().Local().Unix() {
+		return nil, errors.New("token expired")
 	}
-	return
-}
\ No newline at end of file
+	if claims.Id != "" && a.isRevoked(claims.Id) {
+		return nil, errors.New("token revoked")
+	}
+	return claims, nil
+}
+
+// GenerateTokenPair issues a fresh access+refresh pair for a user identified
+// by email/username, persisting the refresh token's hash so it can be looked
+// up, rotated, or revoked later without ever storing it in the clear.
+func (a *Authenticator) GenerateTokenPair(email, username, userAgent, ip string) (TokenPair, error) {
+	user, err := a.store.Users().FindByEmail(context.Background(), email)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	accessToken, err := a.GenerateJWT(email, username)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	refreshToken, err := a.issueRefreshToken(user.ID, userAgent, ip)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	return TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// RotateRefreshToken validates a presented refresh token, revokes it and
+// issues a brand new access+refresh pair in its place. Reusing an already
+// rotated (or expired/revoked) token is always rejected.
+func (a *Authenticator) RotateRefreshToken(rawToken, userAgent, ip string) (TokenPair, error) {
+	ctx := context.Background()
+	hash := hashToken(rawToken)
+
+	stored, err := a.store.RefreshTokens().FindByHash(ctx, hash)
+	if err != nil {
+		return TokenPair{}, errors.New("refresh token not recognized")
+	}
+	if stored.RevokedAt != nil {
+		return TokenPair{}, errors.New("refresh token revoked")
+	}
+	if stored.ExpiresAt.Before(time.Now()) {
+		return TokenPair{}, errors.New("refresh token expired")
+	}
+
+	user, err := a.store.Users().FindByID(ctx, stored.UserID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	if err := a.store.RefreshTokens().Revoke(ctx, hash, time.Now()); err != nil {
+		return TokenPair{}, err
+	}
+
+	accessToken, err := a.GenerateJWT(user.Email, user.Username)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	newRefreshToken, err := a.issueRefreshToken(user.ID, userAgent, ip)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	return TokenPair{AccessToken: accessToken, RefreshToken: newRefreshToken}, nil
+}
+
+// RevokeRefreshToken marks a single refresh token (e.g. the one presented at
+// logout) as revoked so it can no longer be rotated.
+func (a *Authenticator) RevokeRefreshToken(rawToken string) error {
+	return a.store.RefreshTokens().Revoke(context.Background(), hashToken(rawToken), time.Now())
+}
+
+// RevokeAllForUser revokes every outstanding refresh token for a user, e.g.
+// after a password change, so every other session is forced to re-login.
+func (a *Authenticator) RevokeAllForUser(userID uint) error {
+	return a.store.RefreshTokens().RevokeAllForUser(context.Background(), userID, time.Now())
+}
+
+// RevokeAccessToken blacklists the jti of an already-issued access token so
+// ValidateTokens rejects it even though it hasn't expired yet.
+func (a *Authenticator) RevokeAccessToken(signedToken string) error {
+	token, _, err := new(jwt.Parser).ParseUnverified(signedToken, &Claims{})
+	if err != nil {
+		return err
+	}
+	claims, ok := token.Claims.(*Claims)
+	if !ok || claims.Id == "" {
+		return errors.New("token has no jti to revoke")
+	}
+	return a.store.RevokedTokens().Create(context.Background(), &models.RevokedToken{
+		JTI:       claims.Id,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	})
+}
+
+func (a *Authenticator) issueRefreshToken(userID uint, userAgent, ip string) (string, error) {
+	raw, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	record := models.RefreshToken{
+		UserID:    userID,
+		TokenHash: hashToken(raw),
+		ExpiresAt: time.Now().Add(a.refreshTokenTTL),
+		UserAgent: userAgent,
+		IP:        ip,
+	}
+	if err := a.store.RefreshTokens().Create(context.Background(), &record); err != nil {
+		return "", err
+	}
+	return raw, nil
+}
+
+// isRevoked fails closed: a lookup error (a transient DB blip, a dropped
+// connection) is treated as revoked rather than silently letting the token
+// through.
+func (a *Authenticator) isRevoked(jti string) bool {
+	revoked, err := a.store.RevokedTokens().IsRevoked(context.Background(), jti)
+	if err != nil {
+		return true
+	}
+	return revoked
+}
+
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func newOpaqueToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,244 @@
+// Package config loads the typed Config that startup wiring reads from,
+// instead of main.go reaching into os.Getenv directly and each package
+// hard-coding its own settings (the old auth.jwtKey literal, the
+// `root:root@tcp(localhost:3306)/jwt_demo` DSN, and the database package's
+// inconsistent env var names, including the "DATABASEuRL" typo).
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Mode selects which config.<mode>.yaml profile Load reads.
+type Mode string
+
+const (
+	ModeLocal Mode = "local"
+	ModeDev   Mode = "dev"
+	ModeProd  Mode = "prod"
+)
+
+// DBConfig configures the storage layer's SQL backends (gormstore/pgxstore).
+type DBConfig struct {
+	Driver  string `yaml:"driver"`
+	DSN     string `yaml:"dsn"`
+	MaxOpen int    `yaml:"maxOpen"`
+	MaxIdle int    `yaml:"maxIdle"`
+}
+
+// MongoConfig configures mongostore.
+type MongoConfig struct {
+	URI string `yaml:"uri"`
+	DB  string `yaml:"db"`
+}
+
+// OAuthProviderConfig configures a single social login provider. A provider
+// with an empty ClientID is treated as disabled.
+type OAuthProviderConfig struct {
+	ClientID     string `yaml:"clientId"`
+	ClientSecret string `yaml:"clientSecret"`
+	RedirectURL  string `yaml:"redirectUrl"`
+}
+
+// OAuthConfig configures every social login provider this app knows about.
+type OAuthConfig struct {
+	Google OAuthProviderConfig `yaml:"google"`
+	GitHub OAuthProviderConfig `yaml:"github"`
+	Kakao  OAuthProviderConfig `yaml:"kakao"`
+}
+
+// Config is everything startup needs to wire up the app. Load builds one in
+// priority order: defaults, then config.<mode>.yaml, then environment
+// overrides, then CLI flags.
+type Config struct {
+	Mode Mode `yaml:"mode"`
+
+	HTTPAddr string `yaml:"httpAddr"`
+
+	JWTSecret     string        `yaml:"jwtSecret"`
+	JWTAccessTTL  time.Duration `yaml:"jwtAccessTTL"`
+	JWTRefreshTTL time.Duration `yaml:"jwtRefreshTTL"`
+
+	DB    DBConfig    `yaml:"db"`
+	Mongo MongoConfig `yaml:"mongo"`
+
+	OAuth OAuthConfig `yaml:"oauth"`
+
+	CORSOrigins []string `yaml:"corsOrigins"`
+}
+
+func defaults() Config {
+	return Config{
+		Mode:          ModeLocal,
+		HTTPAddr:      ":8000",
+		JWTAccessTTL:  15 * time.Minute,
+		JWTRefreshTTL: 7 * 24 * time.Hour,
+		DB: DBConfig{
+			Driver:  "gorm",
+			MaxOpen: 10,
+			MaxIdle: 5,
+		},
+	}
+}
+
+// Load builds a Config by layering defaults, a config.<mode>.yaml file,
+// environment variables, and finally CLI flags (parsed from args, typically
+// os.Args[1:]). mode comes from the APP_MODE environment variable
+// (local/dev/prod), defaulting to local. It validates required fields and
+// returns a single readable error listing every one that's missing, rather
+// than letting some package fail deep inside at first use.
+func Load(args []string) (Config, error) {
+	cfg := defaults()
+
+	mode := Mode(os.Getenv("APP_MODE"))
+	if mode == "" {
+		mode = ModeLocal
+	}
+	cfg.Mode = mode
+
+	if err := applyFile(&cfg, fmt.Sprintf("config.%s.yaml", mode)); err != nil {
+		return Config{}, err
+	}
+
+	applyEnv(&cfg)
+
+	if err := applyFlags(&cfg, args); err != nil {
+		return Config{}, err
+	}
+
+	if err := validate(cfg); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// applyFile layers a config.<mode>.yaml file over cfg. The file is optional:
+// a mode with no override file just keeps the defaults.
+func applyFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("config: reading %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("config: parsing %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnv layers environment variable overrides over cfg.
+func applyEnv(cfg *Config) {
+	if v := os.Getenv("HTTP_ADDR"); v != "" {
+		cfg.HTTPAddr = v
+	}
+	if v := os.Getenv("JWT_SECRET"); v != "" {
+		cfg.JWTSecret = v
+	}
+	if v := os.Getenv("JWT_ACCESS_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.JWTAccessTTL = d
+		}
+	}
+	if v := os.Getenv("JWT_REFRESH_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.JWTRefreshTTL = d
+		}
+	}
+
+	if v := os.Getenv("STORAGE_DRIVER"); v != "" {
+		cfg.DB.Driver = v
+	}
+	if v := os.Getenv("STORAGE_DSN"); v != "" {
+		cfg.DB.DSN = v
+	}
+	if v := os.Getenv("STORAGE_MAX_OPEN"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.DB.MaxOpen = n
+		}
+	}
+	if v := os.Getenv("STORAGE_MAX_IDLE"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.DB.MaxIdle = n
+		}
+	}
+
+	if v := os.Getenv("MONGO_URI"); v != "" {
+		cfg.Mongo.URI = v
+	}
+	if v := os.Getenv("MONGO_DB"); v != "" {
+		cfg.Mongo.DB = v
+	}
+
+	if v := os.Getenv("CORS_ORIGINS"); v != "" {
+		cfg.CORSOrigins = strings.Split(v, ",")
+	}
+
+	applyOAuthEnv(&cfg.OAuth.Google, "GOOGLE")
+	applyOAuthEnv(&cfg.OAuth.GitHub, "GITHUB")
+	applyOAuthEnv(&cfg.OAuth.Kakao, "KAKAO")
+}
+
+func applyOAuthEnv(provider *OAuthProviderConfig, prefix string) {
+	if v := os.Getenv(prefix + "_CLIENT_ID"); v != "" {
+		provider.ClientID = v
+	}
+	if v := os.Getenv(prefix + "_CLIENT_SECRET"); v != "" {
+		provider.ClientSecret = v
+	}
+	if v := os.Getenv(prefix + "_REDIRECT_URL"); v != "" {
+		provider.RedirectURL = v
+	}
+}
+
+// applyFlags layers CLI flag overrides over cfg. Only the handful of
+// settings worth overriding ad hoc at the command line are exposed here;
+// everything else goes through the config file or environment.
+func applyFlags(cfg *Config, args []string) error {
+	fs := flag.NewFlagSet("shelly", flag.ContinueOnError)
+	httpAddr := fs.String("http-addr", cfg.HTTPAddr, "address to listen on")
+	jwtSecret := fs.String("jwt-secret", cfg.JWTSecret, "JWT signing secret")
+	storageDriver := fs.String("storage-driver", cfg.DB.Driver, "storage backend driver (gorm, pgx, mongo)")
+	storageDSN := fs.String("storage-dsn", cfg.DB.DSN, "SQL storage connection string")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg.HTTPAddr = *httpAddr
+	cfg.JWTSecret = *jwtSecret
+	cfg.DB.Driver = *storageDriver
+	cfg.DB.DSN = *storageDSN
+	return nil
+}
+
+// validate fails fast with every missing required setting at once, instead
+// of letting the app start and fail confusingly on first request.
+func validate(cfg Config) error {
+	var missing []string
+
+	if cfg.JWTSecret == "" {
+		missing = append(missing, "JWT_SECRET")
+	}
+
+	isMongo := cfg.DB.Driver == "mongo" || cfg.DB.Driver == "mongodb"
+	if isMongo && cfg.Mongo.URI == "" {
+		missing = append(missing, "MONGO_URI")
+	}
+	if !isMongo && cfg.DB.DSN == "" {
+		missing = append(missing, "STORAGE_DSN")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("config: missing required settings: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
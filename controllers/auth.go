@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Domains18/SchoolIt/auth"
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// errEmailTaken is returned by registerUser when the email is already in use,
+// so RegisterUser can tell it apart from a genuine storage failure.
+var errEmailTaken = errors.New("email already registered")
+
+type registerRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+	Role     string `json:"role" binding:"required"`
+}
+
+// RegisterUser creates a new local account with a bcrypt-hashed password.
+func RegisterUser(context *gin.Context) {
+	var req registerRequest
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := registerUser(req); err != nil {
+		if err == errEmailTaken {
+			context.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusCreated, gin.H{"message": "user registered"})
+}
+
+type loginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+// GenerateToken verifies the caller's credentials and, on success, issues an
+// access+refresh pair. The WebAuthn and OAuth logins issue only an access JWT.
+func GenerateToken(context *gin.Context) {
+	var req loginRequest
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pair, err := login(req, context.GetHeader("User-Agent"), context.ClientIP())
+	if err != nil {
+		context.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, pair)
+}
+
+// registerUser hashes req's password and persists a new user, failing with
+// errEmailTaken if the email is already registered.
+func registerUser(req registerRequest) error {
+	ctx := context.Background()
+
+	if _, err := store.Users().FindByEmail(ctx, req.Email); err == nil {
+		return errEmailTaken
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return store.Users().Create(ctx, &models.User{
+		Email:    req.Email,
+		Username: req.Username,
+		Password: string(hashed),
+		Role:     req.Role,
+	})
+}
+
+// login verifies req's credentials against the stored bcrypt hash and, on
+// success, issues a fresh access+refresh pair for userAgent/ip.
+func login(req loginRequest, userAgent, ip string) (auth.TokenPair, error) {
+	ctx := context.Background()
+
+	user, err := store.Users().FindByEmail(ctx, req.Email)
+	if err != nil {
+		return auth.TokenPair{}, errors.New("invalid email or password")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return auth.TokenPair{}, errors.New("invalid email or password")
+	}
+
+	return authenticator.GenerateTokenPair(user.Email, user.Username, userAgent, ip)
+}
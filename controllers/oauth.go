@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/Domains18/SchoolIt/auth"
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/Domains18/SchoolIt/oauth"
+	"github.com/Domains18/SchoolIt/sessions"
+	"github.com/Domains18/SchoolIt/storage"
+	"github.com/gin-gonic/gin"
+)
+
+// store is the storage.Store this package persists users and OAuth
+// identities through, and authenticator is what it issues/validates JWTs
+// with. Both are set once by Init at startup.
+var (
+	store         storage.Store
+	authenticator *auth.Authenticator
+)
+
+// Init wires this package to s and a. It must be called once during
+// startup, before any handler in this package is used.
+func Init(s storage.Store, a *auth.Authenticator) {
+	store = s
+	authenticator = a
+}
+
+// oauthStateTTL bounds how long a client has to complete the provider
+// redirect round trip before the stashed anti-CSRF state expires.
+const oauthStateTTL = 10 * time.Minute
+
+// OAuthLogin redirects to the named provider's consent screen, stashing a
+// signed anti-CSRF state value for OAuthCallback to verify.
+func OAuthLogin(context *gin.Context) {
+	provider, ok := oauth.Get(context.Param("provider"))
+	if !ok {
+		context.JSON(http.StatusNotFound, gin.H{"error": "unknown oauth provider"})
+		return
+	}
+
+	state, err := newState()
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if err := sessions.Store(context.Writer, "oauth_state", state, oauthStateTTL); err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.Redirect(http.StatusTemporaryRedirect, provider.AuthURL(state))
+}
+
+// OAuthCallback verifies the returned state, exchanges the code for a
+// profile, links or creates the local user, and issues the same JWT a
+// password login would so the rest of the API is unchanged.
+func OAuthCallback(context *gin.Context) {
+	provider, ok := oauth.Get(context.Param("provider"))
+	if !ok {
+		context.JSON(http.StatusNotFound, gin.H{"error": "unknown oauth provider"})
+		return
+	}
+
+	var expectedState string
+	if err := sessions.Load(context.Request, context.Writer, "oauth_state", &expectedState); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "oauth state expired or missing"})
+		return
+	}
+	if context.Query("state") != expectedState {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "oauth state mismatch"})
+		return
+	}
+
+	profile, err := provider.Exchange(context.Query("code"))
+	if err != nil {
+		context.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := linkOrCreateUser(provider.Name(), profile, currentUserID(context))
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	tokenString, err := authenticator.GenerateJWT(user.Email, user.Username)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, gin.H{"token": tokenString})
+}
+
+// currentUserID returns the already-authenticated caller's ID, if any, so
+// hitting the callback while already logged in links the identity to the
+// existing account instead of creating a new one.
+func currentUserID(context *gin.Context) uint {
+	tokenString := context.GetHeader("Authorization")
+	if tokenString == "" {
+		return 0
+	}
+	claims, err := authenticator.ValidateTokens(tokenString)
+	if err != nil {
+		return 0
+	}
+	return claims.UserID
+}
+
+// linkOrCreateUser resolves a provider profile to a local user: reusing an
+// already-linked identity, attaching to the caller's current session, then
+// falling back to matching (or creating) a user by email.
+func linkOrCreateUser(providerName string, profile oauth.Profile, loggedInUserID uint) (models.User, error) {
+	ctx := context.Background()
+
+	if identity, err := store.OAuthIdentities().FindByProvider(ctx, providerName, profile.ProviderUserID); err == nil {
+		user, err := store.Users().FindByID(ctx, identity.UserID)
+		if err != nil {
+			return models.User{}, err
+		}
+		return *user, nil
+	}
+
+	var user *models.User
+	var err error
+	if loggedInUserID != 0 {
+		user, err = store.Users().FindByID(ctx, loggedInUserID)
+		if err != nil {
+			return models.User{}, err
+		}
+	} else if user, err = store.Users().FindByEmail(ctx, profile.Email); err != nil {
+		user = &models.User{Email: profile.Email, Username: profile.Username}
+		if err := store.Users().Create(ctx, user); err != nil {
+			return models.User{}, err
+		}
+	}
+
+	err = store.OAuthIdentities().Create(ctx, &models.OAuthIdentity{
+		UserID:         user.ID,
+		Provider:       providerName,
+		ProviderUserID: profile.ProviderUserID,
+		Email:          profile.Email,
+	})
+	return *user, err
+}
+
+func newState() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Ping is a minimal authenticated endpoint used to exercise the Auth/
+// RequireRole middleware chain.
+func Ping(context *gin.Context) {
+	context.JSON(http.StatusOK, gin.H{"message": "pong"})
+}
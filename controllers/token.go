@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type refreshRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+// RefreshToken rotates a presented refresh token for a brand new access+refresh
+// pair. It does not require a valid access token, since the access token may
+// already have expired by the time the client needs to refresh.
+func RefreshToken(context *gin.Context) {
+	var req refreshRequest
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pair, err := authenticator.RotateRefreshToken(req.RefreshToken, context.GetHeader("User-Agent"), context.ClientIP())
+	if err != nil {
+		context.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, pair)
+}
+
+// Logout revokes the refresh token for the current session and blacklists the
+// access token's jti so it can't be used again before it naturally expires.
+func Logout(context *gin.Context) {
+	var req refreshRequest
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := authenticator.RevokeRefreshToken(req.RefreshToken); err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if accessToken := context.GetHeader("Authorization"); accessToken != "" {
+		_ = authenticator.RevokeAccessToken(accessToken)
+	}
+
+	context.JSON(http.StatusOK, gin.H{"message": "logged out"})
+}
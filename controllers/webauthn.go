@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Domains18/SchoolIt/sessions"
+	"github.com/Domains18/SchoolIt/webauthn"
+	"github.com/gin-gonic/gin"
+	gowebauthn "github.com/go-webauthn/webauthn/webauthn"
+)
+
+// webauthnSessionTTL bounds how long a client has to complete a
+// register/login ceremony once it's begun.
+const webauthnSessionTTL = 5 * time.Minute
+
+type webauthnBeginRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+// webauthnSession is what gets signed into the session cookie between a
+// ceremony's begin and finish steps.
+type webauthnSession struct {
+	Email string                 `json:"email"`
+	Data  gowebauthn.SessionData `json:"data"`
+}
+
+// WebauthnRegisterBegin starts a passkey registration ceremony and stashes
+// the challenge in a signed session cookie for WebauthnRegisterFinish.
+func WebauthnRegisterBegin(context *gin.Context) {
+	var req webauthnBeginRequest
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	options, sessionData, err := webauthn.BeginRegistration(req.Email)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	session := webauthnSession{Email: req.Email, Data: *sessionData}
+	if err := sessions.Store(context.Writer, "webauthn_register", session, webauthnSessionTTL); err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, options)
+}
+
+// WebauthnRegisterFinish validates the authenticator's response against the
+// stashed challenge and persists the new credential.
+func WebauthnRegisterFinish(context *gin.Context) {
+	var session webauthnSession
+	if err := sessions.Load(context.Request, context.Writer, "webauthn_register", &session); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "registration session expired or missing"})
+		return
+	}
+
+	if err := webauthn.FinishRegistration(session.Email, session.Data, context.Request); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, gin.H{"message": "passkey registered"})
+}
+
+// WebauthnLoginBegin starts a passkey login ceremony and stashes the
+// challenge in a signed session cookie for WebauthnLoginFinish.
+func WebauthnLoginBegin(context *gin.Context) {
+	var req webauthnBeginRequest
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	options, sessionData, err := webauthn.BeginLogin(req.Email)
+	if err != nil {
+		context.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	session := webauthnSession{Email: req.Email, Data: *sessionData}
+	if err := sessions.Store(context.Writer, "webauthn_login", session, webauthnSessionTTL); err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, options)
+}
+
+// WebauthnLoginFinish validates the authenticator's assertion against the
+// stashed challenge and, on success, issues the same JWT a password login
+// would so the rest of the API doesn't need to know which method was used.
+func WebauthnLoginFinish(context *gin.Context) {
+	var session webauthnSession
+	if err := sessions.Load(context.Request, context.Writer, "webauthn_login", &session); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "login session expired or missing"})
+		return
+	}
+
+	user, err := webauthn.FinishLogin(session.Email, session.Data, context.Request)
+	if err != nil {
+		context.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	tokenString, err := authenticator.GenerateJWT(user.Email, user.Username)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, gin.H{"token": tokenString})
+}
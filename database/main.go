@@ -1,31 +0,0 @@
-package database
-
-import (
-	"context"
-	"fmt"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
-	"log"
-	"os"
-	"time"
-)
-
-func ConnectDB() {
-	mongoDbUri := os.Getenv("MONGOURI")
-	if mongoDbUri == "" {
-		panic("No mongoDb Uri Found")
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	clientOptions := options.Client().ApplyURI(mongoDbUri)
-	client, err := mongo.Connect(ctx, clientOptions)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		fmt.Println("service connected to mongodb")
-	}
-}
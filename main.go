@@ -1,34 +1,135 @@
 package main
 
 import (
+	"context"
+	"os"
+
+	"github.com/Domains18/SchoolIt/auth"
+	"github.com/Domains18/SchoolIt/config"
 	"github.com/Domains18/SchoolIt/controllers"
-	"github.com/Domains18/SchoolIt/database"
 	"github.com/Domains18/SchoolIt/middlewares"
+	"github.com/Domains18/SchoolIt/oauth"
+	"github.com/Domains18/SchoolIt/storage"
+	"github.com/Domains18/SchoolIt/storage/gormstore"
+	"github.com/Domains18/SchoolIt/storage/mongostore"
+	"github.com/Domains18/SchoolIt/storage/pgxstore"
+	"github.com/Domains18/SchoolIt/webauthn"
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
-	database.Connect("root:root@tcp(localhost:3306)/jwt_demo?parseTime=true")
-	database.Migrate()
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		panic(err)
+	}
+
+	store, err := newStore(storage.Config{
+		Driver:   cfg.DB.Driver,
+		DSN:      cfg.DB.DSN,
+		MaxOpen:  cfg.DB.MaxOpen,
+		MaxIdle:  cfg.DB.MaxIdle,
+		MongoURI: cfg.Mongo.URI,
+		MongoDB:  cfg.Mongo.DB,
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer store.Close()
+	if err := store.Migrate(context.Background()); err != nil {
+		panic(err)
+	}
+
+	authenticator := auth.New([]byte(cfg.JWTSecret), store, cfg.JWTAccessTTL, cfg.JWTRefreshTTL)
+	controllers.Init(store, authenticator)
+	middlewares.Init(authenticator)
+
+	err = webauthn.Init(webauthn.Config{
+		RPDisplayName: "Shelly",
+		RPID:          "localhost",
+		RPOrigins:     []string{"http://localhost:8000"},
+	}, store)
+	if err != nil {
+		panic(err)
+	}
+	oauth.Init(configuredOAuthProviders(cfg.OAuth)...)
 	//initialize router
-	router := initRouter()
-	err :=router.Run(":8000")
-	if err !=nil {
+	router := initRouter(&cfg)
+	err = router.Run(cfg.HTTPAddr)
+	if err != nil {
 		panic("error")
 	}
 }
 
+// newStore builds the storage.Store named by cfg.Driver ("pgx"/"postgres",
+// "mongo"/"mongodb", or anything else for the default gormstore/MySQL
+// backend), so DB.Driver is the only thing that needs to change to switch
+// engines.
+func newStore(cfg storage.Config) (storage.Store, error) {
+	switch cfg.Driver {
+	case "pgx", "postgres":
+		return pgxstore.New(pgxstore.Config{DSN: cfg.DSN, MaxOpen: cfg.MaxOpen})
+	case "mongo", "mongodb":
+		return mongostore.New(mongostore.Config{URI: cfg.MongoURI, Database: cfg.MongoDB})
+	default:
+		return gormstore.New(gormstore.Config{DSN: cfg.DSN, MaxOpen: cfg.MaxOpen, MaxIdle: cfg.MaxIdle})
+	}
+}
 
-func initRouter() *gin.Engine {
+// initRouter builds the gin engine from cfg, so callers (including tests)
+// can spin one up against an in-memory config instead of the process's
+// real environment.
+func initRouter(cfg *config.Config) *gin.Engine {
 	router := gin.Default()
+	router.Use(middlewares.CORS(cfg.CORSOrigins))
 	api := router.Group("/api")
 	{
 		api.POST("/token", controllers.GenerateToken)
+		api.POST("/token/refresh", controllers.RefreshToken)
 		api.POST("/user/register", controllers.RegisterUser)
+		api.POST("/webauthn/register/begin", controllers.WebauthnRegisterBegin)
+		api.POST("/webauthn/register/finish", controllers.WebauthnRegisterFinish)
+		api.POST("/webauthn/login/begin", controllers.WebauthnLoginBegin)
+		api.POST("/webauthn/login/finish", controllers.WebauthnLoginFinish)
+		api.GET("/oauth/:provider/login", controllers.OAuthLogin)
+		api.GET("/oauth/:provider/callback", controllers.OAuthCallback)
 		secured := api.Group("/secured").Use(middlewares.Auth())
 		{
-			secured.GET("/ping", controllers.Ping)
+			secured.GET("/ping", middlewares.RequireRole("admin", "teacher", "student"), controllers.Ping)
+			secured.POST("/token/logout", controllers.Logout)
 		}
 	}
 	return router
-}
\ No newline at end of file
+}
+
+// configuredOAuthProviders builds a Provider for each social login whose
+// client credentials are present in cfg, so enabling/disabling a provider is
+// just a matter of setting or unsetting its config/env values.
+func configuredOAuthProviders(cfg config.OAuthConfig) []oauth.Provider {
+	var providers []oauth.Provider
+
+	if cfg.Google.ClientID != "" && cfg.Google.ClientSecret != "" {
+		providers = append(providers, oauth.NewGoogleProvider(oauth.GoogleConfig{
+			ClientID:     cfg.Google.ClientID,
+			ClientSecret: cfg.Google.ClientSecret,
+			RedirectURL:  cfg.Google.RedirectURL,
+		}))
+	}
+
+	if cfg.GitHub.ClientID != "" && cfg.GitHub.ClientSecret != "" {
+		providers = append(providers, oauth.NewGitHubProvider(oauth.GitHubConfig{
+			ClientID:     cfg.GitHub.ClientID,
+			ClientSecret: cfg.GitHub.ClientSecret,
+			RedirectURL:  cfg.GitHub.RedirectURL,
+		}))
+	}
+
+	if cfg.Kakao.ClientID != "" && cfg.Kakao.ClientSecret != "" {
+		providers = append(providers, oauth.NewKakaoProvider(oauth.KakaoConfig{
+			ClientID:     cfg.Kakao.ClientID,
+			ClientSecret: cfg.Kakao.ClientSecret,
+			RedirectURL:  cfg.Kakao.RedirectURL,
+		}))
+	}
+
+	return providers
+}
@@ -5,6 +5,21 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// claimsContextKey is where Auth() stashes the parsed token claims so
+// downstream middleware (RequireRole, RequirePermission) can read them
+// without re-validating the token.
+const claimsContextKey = "authClaims"
+
+// authenticator is what Auth() validates tokens with, set once by Init at
+// startup.
+var authenticator *auth.Authenticator
+
+// Init wires this package to a. It must be called once during startup,
+// before Auth() is used.
+func Init(a *auth.Authenticator) {
+	authenticator = a
+}
+
 func Auth() gin.HandlerFunc{
 	return func(context *gin.Context) {
 		tokenString := context.GetHeader("Authorization")
@@ -13,12 +28,24 @@ func Auth() gin.HandlerFunc{
 			context.Abort()
 			return
 		}
-		err := auth.ValidateTokens(tokenString)
+		claims, err := authenticator.ValidateTokens(tokenString)
 		if err != nil {
 			context.JSON(401, gin.H{"errror": err.Error()})
 			context.Abort()
 			return
 		}
+		context.Set(claimsContextKey, claims)
 		context.Next()
 	}
+}
+
+// claimsFromContext fetches the claims Auth() stored on the gin context. It
+// must run after Auth(), otherwise there is nothing to find.
+func claimsFromContext(context *gin.Context) (*auth.Claims, bool) {
+	value, ok := context.Get(claimsContextKey)
+	if !ok {
+		return nil, false
+	}
+	claims, ok := value.(*auth.Claims)
+	return claims, ok
 }
\ No newline at end of file
@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CORS allows cross-origin requests from the given origins. With no origins
+// configured it's a no-op, so local/dev setups that don't set CORS_ORIGINS
+// behave exactly as before.
+func CORS(allowedOrigins []string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[origin] = true
+	}
+
+	return func(context *gin.Context) {
+		if len(allowed) == 0 {
+			context.Next()
+			return
+		}
+
+		origin := context.GetHeader("Origin")
+		if allowed[origin] {
+			context.Header("Access-Control-Allow-Origin", origin)
+			context.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			context.Header("Access-Control-Allow-Headers", "Authorization, Content-Type")
+		}
+
+		if context.Request.Method == http.MethodOptions {
+			context.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+		context.Next()
+	}
+}
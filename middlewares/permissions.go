@@ -0,0 +1,102 @@
+package middlewares
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// rolePermissions is the in-code registry mapping a role to the permission
+// strings it holds. It's intentionally small and flat; once this grows past
+// a handful of roles it should move to the database instead of living here.
+var rolePermissions = map[string][]string{
+	"admin": {
+		"user.create",
+		"notice.publish",
+		"attendance.write",
+		"result.read.self",
+		"result.read.any",
+	},
+	"teacher": {
+		"attendance.write",
+		"result.read.self",
+	},
+	"student": {
+		"result.read.self",
+	},
+}
+
+// hasPermission reports whether role grants perm.
+func hasPermission(role, perm string) bool {
+	for _, granted := range rolePermissions[role] {
+		if granted == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireRole rejects the request with 403 unless Auth() parsed a token
+// belonging to one of roles. It must be chained after Auth().
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		claims, ok := claimsFromContext(context)
+		if !ok {
+			context.JSON(403, gin.H{"error": "missing auth claims"})
+			context.Abort()
+			return
+		}
+		for _, role := range roles {
+			if claims.Role == role {
+				context.Next()
+				return
+			}
+		}
+		context.JSON(403, gin.H{"error": "role not permitted"})
+		context.Abort()
+	}
+}
+
+// RequirePermission rejects the request with 403 unless the caller's role is
+// granted perm in rolePermissions. It must be chained after Auth().
+func RequirePermission(perm string) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		claims, ok := claimsFromContext(context)
+		if !ok {
+			context.JSON(403, gin.H{"error": "missing auth claims"})
+			context.Abort()
+			return
+		}
+		if !hasPermission(claims.Role, perm) {
+			context.JSON(403, gin.H{"error": "permission denied"})
+			context.Abort()
+			return
+		}
+		context.Next()
+	}
+}
+
+// RequireSelfOrPermission allows the request through if the caller's user ID
+// matches idParam's value in the URL (e.g. a student hitting their own
+// /student/:id), or otherwise falls back to RequirePermission. Must be
+// chained after Auth().
+func RequireSelfOrPermission(idParam, perm string) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		claims, ok := claimsFromContext(context)
+		if !ok {
+			context.JSON(403, gin.H{"error": "missing auth claims"})
+			context.Abort()
+			return
+		}
+		if context.Param(idParam) == strconv.FormatUint(uint64(claims.UserID), 10) {
+			context.Next()
+			return
+		}
+		if !hasPermission(claims.Role, perm) {
+			context.JSON(403, gin.H{"error": "permission denied"})
+			context.Abort()
+			return
+		}
+		context.Next()
+	}
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// OAuthIdentity links a federated login (Google/GitHub/Kakao/...) to a local
+// User. The pair (Provider, ProviderUserID) is what the provider guarantees
+// is stable and unique; Email is just whatever the provider last reported.
+type OAuthIdentity struct {
+	ID             uint   `gorm:"primaryKey"`
+	UserID         uint   `gorm:"index;not null"`
+	Provider       string `gorm:"size:32;not null;uniqueIndex:idx_oauth_provider_identity"`
+	ProviderUserID string `gorm:"size:255;not null;uniqueIndex:idx_oauth_provider_identity"`
+	Email          string `gorm:"size:255"`
+	CreatedAt      time.Time
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// RefreshToken is a rotating, opaque session token. Only its SHA-256 hash is
+// ever persisted so a stolen row can't be replayed as a bearer token.
+type RefreshToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index;not null"`
+	TokenHash string `gorm:"size:64;uniqueIndex;not null"`
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	UserAgent string
+	IP        string
+	CreatedAt time.Time
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// RevokedToken records the jti of an access token that was invalidated before
+// it expired naturally (logout, password change, compromise).
+type RevokedToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	JTI       string `gorm:"size:64;uniqueIndex;not null"`
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
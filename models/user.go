@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// User is a local account. It's the identity every auth method (password,
+// refresh token, WebAuthn, OAuth) ultimately resolves to.
+type User struct {
+	ID        uint   `gorm:"primaryKey"`
+	Email     string `gorm:"size:255;uniqueIndex;not null"`
+	Username  string `gorm:"size:255;not null"`
+	Password  string `gorm:"size:255;not null"`
+	Role      string `gorm:"size:32;not null"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// webauthnPepper keeps the derived handle unguessable from the numeric user
+// ID alone. In a real deployment this would be loaded from config alongside
+// the JWT signing key rather than hard-coded.
+var webauthnPepper = []byte("shelly-webauthn-handle-pepper")
+
+// WebauthnID returns a stable byte handle to use as the WebAuthn user
+// handle, so a user's real username is never exposed to authenticators. It's
+// derived deterministically from the user's primary key so the same user
+// always resolves to the same handle across registration and login.
+func (u *User) WebauthnID() []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(u.ID))
+	sum := sha256.Sum256(append(webauthnPepper, buf[:]...))
+	return sum[:]
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// WebauthnCredential is a single registered passkey bound to a user, as
+// returned by a go-webauthn registration ceremony. CredentialID and
+// PublicKey are stored exactly as the authenticator returned them (base64url
+// and raw COSE bytes respectively).
+type WebauthnCredential struct {
+	ID           uint   `gorm:"primaryKey"`
+	UserID       uint   `gorm:"index;not null"`
+	CredentialID string `gorm:"size:255;uniqueIndex;not null"`
+	PublicKey    []byte `gorm:"type:blob;not null"`
+	SignCount    uint32
+	AAGUID       string `gorm:"size:64"`
+	Transports   string `gorm:"size:255"`
+	CreatedAt    time.Time
+}
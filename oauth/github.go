@@ -0,0 +1,122 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+// GitHubConfig holds the client credentials and redirect URL GitHub issued
+// for this application's OAuth app.
+type GitHubConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+type githubProvider struct {
+	oauthConfig *oauth2.Config
+}
+
+// NewGitHubProvider builds a Provider backed by GitHub's OAuth2 endpoints.
+func NewGitHubProvider(cfg GitHubConfig) Provider {
+	return &githubProvider{
+		oauthConfig: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       []string{"read:user", "user:email"},
+			Endpoint:     github.Endpoint,
+		},
+	}
+}
+
+func (p *githubProvider) Name() string { return "github" }
+
+func (p *githubProvider) AuthURL(state string) string {
+	return p.oauthConfig.AuthCodeURL(state)
+}
+
+func (p *githubProvider) Exchange(code string) (Profile, error) {
+	token, err := p.oauthConfig.Exchange(context.Background(), code)
+	if err != nil {
+		return Profile{}, err
+	}
+	client := p.oauthConfig.Client(context.Background(), token)
+
+	resp, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		return Profile{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Profile{}, fmt.Errorf("github: user request failed with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	var raw struct {
+		ID    int    `json:"id"`
+		Login string `json:"login"`
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return Profile{}, err
+	}
+
+	email := raw.Email
+	if email == "" {
+		email, err = primaryGitHubEmail(client)
+		if err != nil {
+			return Profile{}, err
+		}
+	}
+
+	return Profile{ProviderUserID: strconv.Itoa(raw.ID), Email: email, Username: raw.Login}, nil
+}
+
+// primaryGitHubEmail falls back to /user/emails when the profile itself
+// doesn't expose a public email, which is the common case.
+func primaryGitHubEmail(client *http.Client) (string, error) {
+	resp, err := client.Get("https://api.github.com/user/emails")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("github: emails request failed with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := json.Unmarshal(body, &emails); err != nil {
+		return "", err
+	}
+
+	for _, entry := range emails {
+		if entry.Primary && entry.Verified {
+			return entry.Email, nil
+		}
+	}
+	return "", errors.New("github account has no verified primary email")
+}
@@ -0,0 +1,76 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// GoogleConfig holds the client credentials and redirect URL Google issued
+// for this application's OAuth2 client.
+type GoogleConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+type googleProvider struct {
+	oauthConfig *oauth2.Config
+}
+
+// NewGoogleProvider builds a Provider backed by Google's OAuth2 endpoints.
+func NewGoogleProvider(cfg GoogleConfig) Provider {
+	return &googleProvider{
+		oauthConfig: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint:     google.Endpoint,
+		},
+	}
+}
+
+func (p *googleProvider) Name() string { return "google" }
+
+func (p *googleProvider) AuthURL(state string) string {
+	return p.oauthConfig.AuthCodeURL(state)
+}
+
+func (p *googleProvider) Exchange(code string) (Profile, error) {
+	token, err := p.oauthConfig.Exchange(context.Background(), code)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	resp, err := p.oauthConfig.Client(context.Background(), token).Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	if err != nil {
+		return Profile{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Profile{}, fmt.Errorf("google: userinfo request failed with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	var raw struct {
+		ID    string `json:"id"`
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return Profile{}, err
+	}
+
+	return Profile{ProviderUserID: raw.ID, Email: raw.Email, Username: raw.Name}, nil
+}
@@ -0,0 +1,43 @@
+// Package oauth lets users federate into the existing JWT session via a
+// third-party identity provider (Google, GitHub, Kakao, ...) instead of a
+// local username/password.
+package oauth
+
+// Profile is the normalized identity handed back after a successful code
+// exchange, regardless of which provider issued it.
+type Profile struct {
+	ProviderUserID string
+	Email          string
+	Username       string
+}
+
+// Provider is a single OAuth2 identity provider.
+type Provider interface {
+	// Name is the provider key used in routes and the OAuthIdentity table,
+	// e.g. "google".
+	Name() string
+	// AuthURL builds the provider's consent-screen URL for the given
+	// anti-CSRF state value.
+	AuthURL(state string) string
+	// Exchange trades an authorization code for the user's profile.
+	Exchange(code string) (Profile, error)
+}
+
+// providers holds the enabled providers set up at startup, keyed by Name().
+var providers = map[string]Provider{}
+
+// Init registers the enabled providers. It must be called once during
+// startup, before any route uses Get. Providers not passed here are simply
+// unreachable, which is how enabling/disabling a provider is controlled.
+func Init(enabled ...Provider) {
+	providers = make(map[string]Provider, len(enabled))
+	for _, provider := range enabled {
+		providers[provider.Name()] = provider
+	}
+}
+
+// Get looks up a configured provider by name (e.g. "google").
+func Get(name string) (Provider, bool) {
+	provider, ok := providers[name]
+	return provider, ok
+}
@@ -0,0 +1,111 @@
+// Package sessions provides short-lived, signed-cookie-backed server state
+// for multi-step flows (like a WebAuthn ceremony) that need to survive
+// between two requests without a server-side session store. gin doesn't
+// ship anything for this today.
+package sessions
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// signingKey authenticates session cookies so a client can't forge or tamper
+// with the challenge it carries. Unlike the JWT signing secret, which
+// auth.New takes from config, it is a fixed in-code value.
+var signingKey = []byte("shelly-session-signing-key")
+
+const cookiePrefix = "shelly_session_"
+
+// envelope is what actually gets signed: value plus the absolute instant it
+// stops being valid. Embedding the expiry in the signed payload means a
+// captured raw cookie value can't be replayed past its TTL just because a
+// proxy or client no longer honors the cookie's own Expires attribute.
+type envelope struct {
+	Value   json.RawMessage `json:"value"`
+	Expires int64           `json:"expires"`
+}
+
+// Store signs and sets value as an HTTP-only cookie named name, valid for
+// ttl. Intended for short-lived challenges (WebAuthn SessionData, OAuth
+// state) rather than general-purpose session storage.
+func Store(w http.ResponseWriter, name string, value interface{}, ttl time.Duration) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	expires := time.Now().Add(ttl)
+	payload, err := json.Marshal(envelope{Value: raw, Expires: expires.Unix()})
+	if err != nil {
+		return err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookiePrefix + name,
+		Value:    encoded + "." + sign(name, encoded),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  expires,
+		Path:     "/",
+	})
+	return nil
+}
+
+// Load reads back a value stored with Store into dest, verifying its
+// signature and expiry, and clears the cookie so the challenge can't be
+// replayed.
+func Load(r *http.Request, w http.ResponseWriter, name string, dest interface{}) error {
+	cookie, err := r.Cookie(cookiePrefix + name)
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(cookie.Value, ".", 2)
+	if len(parts) != 2 {
+		return errors.New("malformed session cookie")
+	}
+	encoded, signature := parts[0], parts[1]
+	if !hmac.Equal([]byte(sign(name, encoded)), []byte(signature)) {
+		return errors.New("session cookie signature mismatch")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		return err
+	}
+
+	Clear(w, name)
+
+	if time.Now().Unix() > env.Expires {
+		return errors.New("session cookie expired")
+	}
+	return json.Unmarshal(env.Value, dest)
+}
+
+// Clear expires the named session cookie immediately.
+func Clear(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookiePrefix + name,
+		Value:    "",
+		HttpOnly: true,
+		Expires:  time.Unix(0, 0),
+		Path:     "/",
+	})
+}
+
+func sign(name, encoded string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(name + ":" + encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,56 @@
+package gormstore
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"gorm.io/gorm"
+)
+
+// complaintRow is gormstore's own persistence shape for storage.Complaint.
+type complaintRow struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint
+	Date      time.Time
+	Complaint string
+	School    string
+}
+
+type complaintRepo struct {
+	db *gorm.DB
+}
+
+func (r *complaintRepo) Create(ctx context.Context, complaint *storage.Complaint) error {
+	row := complaintRow{
+		UserID:    complaint.UserID,
+		Date:      complaint.Date,
+		Complaint: complaint.Complaint,
+		School:    complaint.School,
+	}
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return err
+	}
+	complaint.ID = strconv.FormatUint(uint64(row.ID), 10)
+	return nil
+}
+
+func (r *complaintRepo) ListBySchool(ctx context.Context, school string) ([]storage.Complaint, error) {
+	var rows []complaintRow
+	if err := r.db.WithContext(ctx).Where("school = ?", school).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	complaints := make([]storage.Complaint, 0, len(rows))
+	for _, row := range rows {
+		complaints = append(complaints, storage.Complaint{
+			ID:        strconv.FormatUint(uint64(row.ID), 10),
+			UserID:    row.UserID,
+			Date:      row.Date,
+			Complaint: row.Complaint,
+			School:    row.School,
+		})
+	}
+	return complaints, nil
+}
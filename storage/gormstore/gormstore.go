@@ -0,0 +1,75 @@
+// Package gormstore is the GORM/MySQL implementation of storage.Store.
+package gormstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/Domains18/SchoolIt/storage"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// Config holds the connection settings gormstore needs to open a pool.
+type Config struct {
+	DSN     string
+	MaxOpen int
+	MaxIdle int
+}
+
+// Store is the GORM-backed implementation of storage.Store.
+type Store struct {
+	db *gorm.DB
+}
+
+// New opens a MySQL connection pool and wraps it as a Store.
+func New(cfg Config) (storage.Store, error) {
+	db, err := gorm.Open(mysql.Open(cfg.DSN), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxOpen > 0 {
+		sqlDB.SetMaxOpenConns(cfg.MaxOpen)
+	}
+	if cfg.MaxIdle > 0 {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdle)
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Users() storage.UserRepo           { return &userRepo{db: s.db} }
+func (s *Store) Notices() storage.NoticeRepo       { return &noticeRepo{db: s.db} }
+func (s *Store) Complaints() storage.ComplaintRepo { return &complaintRepo{db: s.db} }
+
+func (s *Store) RefreshTokens() storage.RefreshTokenRepo { return &refreshTokenRepo{db: s.db} }
+func (s *Store) RevokedTokens() storage.RevokedTokenRepo { return &revokedTokenRepo{db: s.db} }
+func (s *Store) WebauthnCredentials() storage.WebauthnCredentialRepo {
+	return &webauthnCredentialRepo{db: s.db}
+}
+func (s *Store) OAuthIdentities() storage.OAuthIdentityRepo { return &oauthIdentityRepo{db: s.db} }
+
+func (s *Store) Migrate(ctx context.Context) error {
+	return s.db.WithContext(ctx).AutoMigrate(
+		&models.User{},
+		&models.RefreshToken{},
+		&models.RevokedToken{},
+		&models.WebauthnCredential{},
+		&models.OAuthIdentity{},
+		&noticeRow{},
+		&complaintRow{},
+	)
+}
+
+func (s *Store) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
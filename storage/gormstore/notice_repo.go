@@ -0,0 +1,53 @@
+package gormstore
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"gorm.io/gorm"
+)
+
+// noticeRow is gormstore's own persistence shape for storage.Notice. It
+// doesn't reuse types.Notice, which is tagged for Mongo's ObjectID rather
+// than an auto-incrementing SQL primary key.
+type noticeRow struct {
+	ID      uint `gorm:"primaryKey"`
+	Title   string
+	Details string
+	Date    time.Time
+	School  string
+}
+
+type noticeRepo struct {
+	db *gorm.DB
+}
+
+func (r *noticeRepo) Create(ctx context.Context, notice *storage.Notice) error {
+	row := noticeRow{Title: notice.Title, Details: notice.Details, Date: notice.Date, School: notice.School}
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return err
+	}
+	notice.ID = strconv.FormatUint(uint64(row.ID), 10)
+	return nil
+}
+
+func (r *noticeRepo) ListBySchool(ctx context.Context, school string) ([]storage.Notice, error) {
+	var rows []noticeRow
+	if err := r.db.WithContext(ctx).Where("school = ?", school).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	notices := make([]storage.Notice, 0, len(rows))
+	for _, row := range rows {
+		notices = append(notices, storage.Notice{
+			ID:      strconv.FormatUint(uint64(row.ID), 10),
+			Title:   row.Title,
+			Details: row.Details,
+			Date:    row.Date,
+			School:  row.School,
+		})
+	}
+	return notices, nil
+}
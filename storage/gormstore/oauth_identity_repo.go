@@ -0,0 +1,27 @@
+package gormstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"gorm.io/gorm"
+)
+
+type oauthIdentityRepo struct {
+	db *gorm.DB
+}
+
+func (r *oauthIdentityRepo) Create(ctx context.Context, identity *models.OAuthIdentity) error {
+	return r.db.WithContext(ctx).Create(identity).Error
+}
+
+func (r *oauthIdentityRepo) FindByProvider(ctx context.Context, provider, providerUserID string) (*models.OAuthIdentity, error) {
+	var identity models.OAuthIdentity
+	err := r.db.WithContext(ctx).
+		Where("provider = ? AND provider_user_id = ?", provider, providerUserID).
+		First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
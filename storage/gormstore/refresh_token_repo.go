@@ -0,0 +1,37 @@
+package gormstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/models"
+	"gorm.io/gorm"
+)
+
+type refreshTokenRepo struct {
+	db *gorm.DB
+}
+
+func (r *refreshTokenRepo) Create(ctx context.Context, token *models.RefreshToken) error {
+	return r.db.WithContext(ctx).Create(token).Error
+}
+
+func (r *refreshTokenRepo) FindByHash(ctx context.Context, hash string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", hash).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepo) Revoke(ctx context.Context, hash string, revokedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("token_hash = ? AND revoked_at IS NULL", hash).
+		Update("revoked_at", revokedAt).Error
+}
+
+func (r *refreshTokenRepo) RevokeAllForUser(ctx context.Context, userID uint, revokedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", revokedAt).Error
+}
@@ -0,0 +1,22 @@
+package gormstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"gorm.io/gorm"
+)
+
+type revokedTokenRepo struct {
+	db *gorm.DB
+}
+
+func (r *revokedTokenRepo) Create(ctx context.Context, token *models.RevokedToken) error {
+	return r.db.WithContext(ctx).Create(token).Error
+}
+
+func (r *revokedTokenRepo) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,30 @@
+package gormstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"gorm.io/gorm"
+)
+
+type webauthnCredentialRepo struct {
+	db *gorm.DB
+}
+
+func (r *webauthnCredentialRepo) Create(ctx context.Context, credential *models.WebauthnCredential) error {
+	return r.db.WithContext(ctx).Create(credential).Error
+}
+
+func (r *webauthnCredentialRepo) ListByUser(ctx context.Context, userID uint) ([]models.WebauthnCredential, error) {
+	var rows []models.WebauthnCredential
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *webauthnCredentialRepo) UpdateSignCount(ctx context.Context, credentialID string, signCount uint32) error {
+	return r.db.WithContext(ctx).Model(&models.WebauthnCredential{}).
+		Where("credential_id = ?", credentialID).
+		Update("sign_count", signCount).Error
+}
@@ -0,0 +1,62 @@
+package mongostore
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type complaintDocument struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    uint               `bson:"userId"`
+	Date      time.Time          `bson:"date"`
+	Complaint string             `bson:"complaint"`
+	School    string             `bson:"school"`
+}
+
+type complaintRepo struct {
+	collection *mongo.Collection
+}
+
+func (r *complaintRepo) Create(ctx context.Context, complaint *storage.Complaint) error {
+	doc := complaintDocument{
+		UserID:    complaint.UserID,
+		Date:      complaint.Date,
+		Complaint: complaint.Complaint,
+		School:    complaint.School,
+	}
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return err
+	}
+	complaint.ID = result.InsertedID.(primitive.ObjectID).Hex()
+	return nil
+}
+
+func (r *complaintRepo) ListBySchool(ctx context.Context, school string) ([]storage.Complaint, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"school": school})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var complaints []storage.Complaint
+	for cursor.Next(ctx) {
+		var doc complaintDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		complaints = append(complaints, storage.Complaint{
+			ID:        doc.ID.Hex(),
+			UserID:    doc.UserID,
+			Date:      doc.Date,
+			Complaint: doc.Complaint,
+			School:    doc.School,
+		})
+	}
+	return complaints, cursor.Err()
+}
@@ -0,0 +1,91 @@
+// Package mongostore is the MongoDB implementation of storage.Store.
+package mongostore
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Config holds the connection settings mongostore needs to reach the
+// cluster and pick a database.
+type Config struct {
+	URI      string
+	Database string
+}
+
+// Store is the Mongo-backed implementation of storage.Store.
+type Store struct {
+	client *mongo.Client
+	db     *mongo.Database
+}
+
+// New connects to MongoDB and wraps it as a Store. Unlike the old
+// database.ConnectDB, a failed ping is returned as an error instead of only
+// being logged.
+func New(cfg Config) (storage.Store, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.URI))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	return &Store{client: client, db: client.Database(cfg.Database)}, nil
+}
+
+func (s *Store) Users() storage.UserRepo {
+	return &userRepo{store: s, collection: s.db.Collection("users")}
+}
+func (s *Store) Notices() storage.NoticeRepo {
+	return &noticeRepo{collection: s.db.Collection("notices")}
+}
+func (s *Store) Complaints() storage.ComplaintRepo {
+	return &complaintRepo{collection: s.db.Collection("complaints")}
+}
+func (s *Store) RefreshTokens() storage.RefreshTokenRepo {
+	return &refreshTokenRepo{collection: s.db.Collection("refresh_tokens")}
+}
+func (s *Store) RevokedTokens() storage.RevokedTokenRepo {
+	return &revokedTokenRepo{collection: s.db.Collection("revoked_tokens")}
+}
+func (s *Store) WebauthnCredentials() storage.WebauthnCredentialRepo {
+	return &webauthnCredentialRepo{collection: s.db.Collection("webauthn_credentials")}
+}
+func (s *Store) OAuthIdentities() storage.OAuthIdentityRepo {
+	return &oauthIdentityRepo{collection: s.db.Collection("oauth_identities")}
+}
+
+// Migrate is a no-op for Mongo: collections are created on first insert.
+// It exists only to satisfy storage.Store.
+func (s *Store) Migrate(ctx context.Context) error {
+	return nil
+}
+
+func (s *Store) Close() error {
+	return s.client.Disconnect(context.Background())
+}
+
+// nextSequence atomically increments and returns the named counter, giving
+// Mongo documents the same auto-incrementing uint ID that SQL's SERIAL/AUTO
+// INCREMENT gives the other two backends.
+func (s *Store) nextSequence(ctx context.Context, name string) (uint, error) {
+	var result struct {
+		Seq uint `bson:"seq"`
+	}
+	err := s.db.Collection("counters").FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&result)
+	return result.Seq, err
+}
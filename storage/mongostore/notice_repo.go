@@ -0,0 +1,57 @@
+package mongostore
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type noticeDocument struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty"`
+	Title   string             `bson:"title"`
+	Details string             `bson:"details"`
+	Date    time.Time          `bson:"date"`
+	School  string             `bson:"school"`
+}
+
+type noticeRepo struct {
+	collection *mongo.Collection
+}
+
+func (r *noticeRepo) Create(ctx context.Context, notice *storage.Notice) error {
+	doc := noticeDocument{Title: notice.Title, Details: notice.Details, Date: notice.Date, School: notice.School}
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return err
+	}
+	notice.ID = result.InsertedID.(primitive.ObjectID).Hex()
+	return nil
+}
+
+func (r *noticeRepo) ListBySchool(ctx context.Context, school string) ([]storage.Notice, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"school": school})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var notices []storage.Notice
+	for cursor.Next(ctx) {
+		var doc noticeDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		notices = append(notices, storage.Notice{
+			ID:      doc.ID.Hex(),
+			Title:   doc.Title,
+			Details: doc.Details,
+			Date:    doc.Date,
+			School:  doc.School,
+		})
+	}
+	return notices, cursor.Err()
+}
@@ -0,0 +1,44 @@
+package mongostore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type oauthIdentityDocument struct {
+	UserID         uint   `bson:"userId"`
+	Provider       string `bson:"provider"`
+	ProviderUserID string `bson:"providerUserId"`
+	Email          string `bson:"email"`
+}
+
+type oauthIdentityRepo struct {
+	collection *mongo.Collection
+}
+
+func (r *oauthIdentityRepo) Create(ctx context.Context, identity *models.OAuthIdentity) error {
+	_, err := r.collection.InsertOne(ctx, oauthIdentityDocument{
+		UserID:         identity.UserID,
+		Provider:       identity.Provider,
+		ProviderUserID: identity.ProviderUserID,
+		Email:          identity.Email,
+	})
+	return err
+}
+
+func (r *oauthIdentityRepo) FindByProvider(ctx context.Context, provider, providerUserID string) (*models.OAuthIdentity, error) {
+	var doc oauthIdentityDocument
+	err := r.collection.FindOne(ctx, bson.M{"provider": provider, "providerUserId": providerUserID}).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OAuthIdentity{
+		UserID:         doc.UserID,
+		Provider:       doc.Provider,
+		ProviderUserID: doc.ProviderUserID,
+		Email:          doc.Email,
+	}, nil
+}
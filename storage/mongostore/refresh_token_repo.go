@@ -0,0 +1,68 @@
+package mongostore
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// refreshTokenDocument is mongostore's persistence shape for
+// models.RefreshToken. It's looked up by TokenHash, so unlike userDocument
+// it doesn't need a seqId of its own.
+type refreshTokenDocument struct {
+	UserID    uint       `bson:"userId"`
+	TokenHash string     `bson:"tokenHash"`
+	ExpiresAt time.Time  `bson:"expiresAt"`
+	RevokedAt *time.Time `bson:"revokedAt,omitempty"`
+	UserAgent string     `bson:"userAgent"`
+	IP        string     `bson:"ip"`
+}
+
+type refreshTokenRepo struct {
+	collection *mongo.Collection
+}
+
+func (r *refreshTokenRepo) Create(ctx context.Context, token *models.RefreshToken) error {
+	_, err := r.collection.InsertOne(ctx, refreshTokenDocument{
+		UserID:    token.UserID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		UserAgent: token.UserAgent,
+		IP:        token.IP,
+	})
+	return err
+}
+
+func (r *refreshTokenRepo) FindByHash(ctx context.Context, hash string) (*models.RefreshToken, error) {
+	var doc refreshTokenDocument
+	if err := r.collection.FindOne(ctx, bson.M{"tokenHash": hash}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &models.RefreshToken{
+		UserID:    doc.UserID,
+		TokenHash: doc.TokenHash,
+		ExpiresAt: doc.ExpiresAt,
+		RevokedAt: doc.RevokedAt,
+		UserAgent: doc.UserAgent,
+		IP:        doc.IP,
+	}, nil
+}
+
+func (r *refreshTokenRepo) Revoke(ctx context.Context, hash string, revokedAt time.Time) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"tokenHash": hash, "revokedAt": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revokedAt": revokedAt}},
+	)
+	return err
+}
+
+func (r *refreshTokenRepo) RevokeAllForUser(ctx context.Context, userID uint, revokedAt time.Time) error {
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"userId": userID, "revokedAt": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revokedAt": revokedAt}},
+	)
+	return err
+}
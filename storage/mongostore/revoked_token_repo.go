@@ -0,0 +1,29 @@
+package mongostore
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type revokedTokenDocument struct {
+	JTI       string    `bson:"jti"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+type revokedTokenRepo struct {
+	collection *mongo.Collection
+}
+
+func (r *revokedTokenRepo) Create(ctx context.Context, token *models.RevokedToken) error {
+	_, err := r.collection.InsertOne(ctx, revokedTokenDocument{JTI: token.JTI, ExpiresAt: token.ExpiresAt})
+	return err
+}
+
+func (r *revokedTokenRepo) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"jti": jti})
+	return count > 0, err
+}
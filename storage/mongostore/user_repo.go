@@ -0,0 +1,72 @@
+package mongostore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// userDocument is mongostore's persistence shape for models.User. SeqID
+// mirrors the SQL backends' auto-increment primary key so UserRepo.FindByID
+// behaves the same regardless of which Store is in use.
+type userDocument struct {
+	SeqID    uint   `bson:"seqId"`
+	Email    string `bson:"email"`
+	Username string `bson:"username"`
+	Password string `bson:"password"`
+	Role     string `bson:"role"`
+}
+
+type userRepo struct {
+	store      *Store
+	collection *mongo.Collection
+}
+
+func (r *userRepo) Create(ctx context.Context, user *models.User) error {
+	seq, err := r.store.nextSequence(ctx, "users")
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.InsertOne(ctx, userDocument{
+		SeqID:    seq,
+		Email:    user.Email,
+		Username: user.Username,
+		Password: user.Password,
+		Role:     user.Role,
+	})
+	if err != nil {
+		return err
+	}
+
+	user.ID = seq
+	return nil
+}
+
+func (r *userRepo) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	var doc userDocument
+	if err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return toModelUser(doc), nil
+}
+
+func (r *userRepo) FindByID(ctx context.Context, id uint) (*models.User, error) {
+	var doc userDocument
+	if err := r.collection.FindOne(ctx, bson.M{"seqId": id}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return toModelUser(doc), nil
+}
+
+func toModelUser(doc userDocument) *models.User {
+	return &models.User{
+		ID:       doc.SeqID,
+		Email:    doc.Email,
+		Username: doc.Username,
+		Password: doc.Password,
+		Role:     doc.Role,
+	}
+}
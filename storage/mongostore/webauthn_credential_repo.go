@@ -0,0 +1,67 @@
+package mongostore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type webauthnCredentialDocument struct {
+	UserID       uint   `bson:"userId"`
+	CredentialID string `bson:"credentialId"`
+	PublicKey    []byte `bson:"publicKey"`
+	SignCount    uint32 `bson:"signCount"`
+	AAGUID       string `bson:"aaguid"`
+	Transports   string `bson:"transports"`
+}
+
+type webauthnCredentialRepo struct {
+	collection *mongo.Collection
+}
+
+func (r *webauthnCredentialRepo) Create(ctx context.Context, credential *models.WebauthnCredential) error {
+	_, err := r.collection.InsertOne(ctx, webauthnCredentialDocument{
+		UserID:       credential.UserID,
+		CredentialID: credential.CredentialID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.SignCount,
+		AAGUID:       credential.AAGUID,
+		Transports:   credential.Transports,
+	})
+	return err
+}
+
+func (r *webauthnCredentialRepo) ListByUser(ctx context.Context, userID uint) ([]models.WebauthnCredential, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"userId": userID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var credentials []models.WebauthnCredential
+	for cursor.Next(ctx) {
+		var doc webauthnCredentialDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, models.WebauthnCredential{
+			UserID:       doc.UserID,
+			CredentialID: doc.CredentialID,
+			PublicKey:    doc.PublicKey,
+			SignCount:    doc.SignCount,
+			AAGUID:       doc.AAGUID,
+			Transports:   doc.Transports,
+		})
+	}
+	return credentials, cursor.Err()
+}
+
+func (r *webauthnCredentialRepo) UpdateSignCount(ctx context.Context, credentialID string, signCount uint32) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"credentialId": credentialID},
+		bson.M{"$set": bson.M{"signCount": signCount}},
+	)
+	return err
+}
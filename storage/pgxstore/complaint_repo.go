@@ -0,0 +1,48 @@
+package pgxstore
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type complaintRepo struct {
+	pool *pgxpool.Pool
+}
+
+func (r *complaintRepo) Create(ctx context.Context, complaint *storage.Complaint) error {
+	var id int64
+	err := r.pool.QueryRow(ctx,
+		`INSERT INTO complaints (user_id, date, complaint, school) VALUES ($1, $2, $3, $4) RETURNING id`,
+		complaint.UserID, complaint.Date, complaint.Complaint, complaint.School,
+	).Scan(&id)
+	if err != nil {
+		return err
+	}
+	complaint.ID = strconv.FormatInt(id, 10)
+	return nil
+}
+
+func (r *complaintRepo) ListBySchool(ctx context.Context, school string) ([]storage.Complaint, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT id, user_id, date, complaint, school FROM complaints WHERE school = $1`, school,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var complaints []storage.Complaint
+	for rows.Next() {
+		var id int64
+		var complaint storage.Complaint
+		if err := rows.Scan(&id, &complaint.UserID, &complaint.Date, &complaint.Complaint, &complaint.School); err != nil {
+			return nil, err
+		}
+		complaint.ID = strconv.FormatInt(id, 10)
+		complaints = append(complaints, complaint)
+	}
+	return complaints, rows.Err()
+}
@@ -0,0 +1,48 @@
+package pgxstore
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type noticeRepo struct {
+	pool *pgxpool.Pool
+}
+
+func (r *noticeRepo) Create(ctx context.Context, notice *storage.Notice) error {
+	var id int64
+	err := r.pool.QueryRow(ctx,
+		`INSERT INTO notices (title, details, date, school) VALUES ($1, $2, $3, $4) RETURNING id`,
+		notice.Title, notice.Details, notice.Date, notice.School,
+	).Scan(&id)
+	if err != nil {
+		return err
+	}
+	notice.ID = strconv.FormatInt(id, 10)
+	return nil
+}
+
+func (r *noticeRepo) ListBySchool(ctx context.Context, school string) ([]storage.Notice, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT id, title, details, date, school FROM notices WHERE school = $1`, school,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notices []storage.Notice
+	for rows.Next() {
+		var id int64
+		var notice storage.Notice
+		if err := rows.Scan(&id, &notice.Title, &notice.Details, &notice.Date, &notice.School); err != nil {
+			return nil, err
+		}
+		notice.ID = strconv.FormatInt(id, 10)
+		notices = append(notices, notice)
+	}
+	return notices, rows.Err()
+}
@@ -0,0 +1,33 @@
+package pgxstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type oauthIdentityRepo struct {
+	pool *pgxpool.Pool
+}
+
+func (r *oauthIdentityRepo) Create(ctx context.Context, identity *models.OAuthIdentity) error {
+	return r.pool.QueryRow(ctx,
+		`INSERT INTO oauth_identities (user_id, provider, provider_user_id, email)
+		 VALUES ($1, $2, $3, $4) RETURNING id`,
+		identity.UserID, identity.Provider, identity.ProviderUserID, identity.Email,
+	).Scan(&identity.ID)
+}
+
+func (r *oauthIdentityRepo) FindByProvider(ctx context.Context, provider, providerUserID string) (*models.OAuthIdentity, error) {
+	var identity models.OAuthIdentity
+	err := r.pool.QueryRow(ctx,
+		`SELECT id, user_id, provider, provider_user_id, email
+		 FROM oauth_identities WHERE provider = $1 AND provider_user_id = $2`,
+		provider, providerUserID,
+	).Scan(&identity.ID, &identity.UserID, &identity.Provider, &identity.ProviderUserID, &identity.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
@@ -0,0 +1,121 @@
+// Package pgxstore is the Postgres (via pgx) implementation of
+// storage.Store.
+package pgxstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/storage"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Config holds the connection settings pgxstore needs to open a pool.
+type Config struct {
+	DSN     string
+	MaxOpen int
+}
+
+// Store is the pgx-backed implementation of storage.Store.
+type Store struct {
+	pool *pgxpool.Pool
+}
+
+// New opens a Postgres connection pool and wraps it as a Store. Unlike the
+// old database.ConnectDatabase, the pool stays open for the process
+// lifetime instead of being closed right after the first query.
+func New(cfg Config) (storage.Store, error) {
+	poolConfig, err := pgxpool.ParseConfig(cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxOpen > 0 {
+		poolConfig.MaxConns = int32(cfg.MaxOpen)
+	}
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := pool.Ping(context.Background()); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &Store{pool: pool}, nil
+}
+
+func (s *Store) Users() storage.UserRepo           { return &userRepo{pool: s.pool} }
+func (s *Store) Notices() storage.NoticeRepo       { return &noticeRepo{pool: s.pool} }
+func (s *Store) Complaints() storage.ComplaintRepo { return &complaintRepo{pool: s.pool} }
+
+func (s *Store) RefreshTokens() storage.RefreshTokenRepo { return &refreshTokenRepo{pool: s.pool} }
+func (s *Store) RevokedTokens() storage.RevokedTokenRepo { return &revokedTokenRepo{pool: s.pool} }
+func (s *Store) WebauthnCredentials() storage.WebauthnCredentialRepo {
+	return &webauthnCredentialRepo{pool: s.pool}
+}
+func (s *Store) OAuthIdentities() storage.OAuthIdentityRepo {
+	return &oauthIdentityRepo{pool: s.pool}
+}
+
+func (s *Store) Migrate(ctx context.Context) error {
+	_, err := s.pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS users (
+			id SERIAL PRIMARY KEY,
+			email TEXT UNIQUE NOT NULL,
+			username TEXT NOT NULL,
+			password TEXT NOT NULL,
+			role TEXT NOT NULL DEFAULT ''
+		);
+		CREATE TABLE IF NOT EXISTS notices (
+			id SERIAL PRIMARY KEY,
+			title TEXT NOT NULL,
+			details TEXT NOT NULL,
+			date TIMESTAMPTZ NOT NULL,
+			school TEXT NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS complaints (
+			id SERIAL PRIMARY KEY,
+			user_id INTEGER NOT NULL,
+			date TIMESTAMPTZ NOT NULL,
+			complaint TEXT NOT NULL,
+			school TEXT NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS refresh_tokens (
+			id SERIAL PRIMARY KEY,
+			user_id INTEGER NOT NULL,
+			token_hash TEXT UNIQUE NOT NULL,
+			expires_at TIMESTAMPTZ NOT NULL,
+			revoked_at TIMESTAMPTZ,
+			user_agent TEXT,
+			ip TEXT
+		);
+		CREATE TABLE IF NOT EXISTS revoked_tokens (
+			id SERIAL PRIMARY KEY,
+			jti TEXT UNIQUE NOT NULL,
+			expires_at TIMESTAMPTZ NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS webauthn_credentials (
+			id SERIAL PRIMARY KEY,
+			user_id INTEGER NOT NULL,
+			credential_id TEXT UNIQUE NOT NULL,
+			public_key BYTEA NOT NULL,
+			sign_count INTEGER NOT NULL DEFAULT 0,
+			aaguid TEXT,
+			transports TEXT
+		);
+		CREATE TABLE IF NOT EXISTS oauth_identities (
+			id SERIAL PRIMARY KEY,
+			user_id INTEGER NOT NULL,
+			provider TEXT NOT NULL,
+			provider_user_id TEXT NOT NULL,
+			email TEXT,
+			UNIQUE (provider, provider_user_id)
+		);
+	`)
+	return err
+}
+
+func (s *Store) Close() error {
+	s.pool.Close()
+	return nil
+}
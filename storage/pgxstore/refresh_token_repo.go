@@ -0,0 +1,49 @@
+package pgxstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type refreshTokenRepo struct {
+	pool *pgxpool.Pool
+}
+
+func (r *refreshTokenRepo) Create(ctx context.Context, token *models.RefreshToken) error {
+	return r.pool.QueryRow(ctx,
+		`INSERT INTO refresh_tokens (user_id, token_hash, expires_at, user_agent, ip)
+		 VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		token.UserID, token.TokenHash, token.ExpiresAt, token.UserAgent, token.IP,
+	).Scan(&token.ID)
+}
+
+func (r *refreshTokenRepo) FindByHash(ctx context.Context, hash string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	err := r.pool.QueryRow(ctx,
+		`SELECT id, user_id, token_hash, expires_at, revoked_at, user_agent, ip
+		 FROM refresh_tokens WHERE token_hash = $1`, hash,
+	).Scan(&token.ID, &token.UserID, &token.TokenHash, &token.ExpiresAt, &token.RevokedAt, &token.UserAgent, &token.IP)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepo) Revoke(ctx context.Context, hash string, revokedAt time.Time) error {
+	_, err := r.pool.Exec(ctx,
+		`UPDATE refresh_tokens SET revoked_at = $1 WHERE token_hash = $2 AND revoked_at IS NULL`,
+		revokedAt, hash,
+	)
+	return err
+}
+
+func (r *refreshTokenRepo) RevokeAllForUser(ctx context.Context, userID uint, revokedAt time.Time) error {
+	_, err := r.pool.Exec(ctx,
+		`UPDATE refresh_tokens SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`,
+		revokedAt, userID,
+	)
+	return err
+}
@@ -0,0 +1,25 @@
+package pgxstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type revokedTokenRepo struct {
+	pool *pgxpool.Pool
+}
+
+func (r *revokedTokenRepo) Create(ctx context.Context, token *models.RevokedToken) error {
+	return r.pool.QueryRow(ctx,
+		`INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2) RETURNING id`,
+		token.JTI, token.ExpiresAt,
+	).Scan(&token.ID)
+}
+
+func (r *revokedTokenRepo) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM revoked_tokens WHERE jti = $1`, jti).Scan(&count)
+	return count > 0, err
+}
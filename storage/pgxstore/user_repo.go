@@ -0,0 +1,41 @@
+package pgxstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type userRepo struct {
+	pool *pgxpool.Pool
+}
+
+func (r *userRepo) Create(ctx context.Context, user *models.User) error {
+	return r.pool.QueryRow(ctx,
+		`INSERT INTO users (email, username, password, role) VALUES ($1, $2, $3, $4) RETURNING id`,
+		user.Email, user.Username, user.Password, user.Role,
+	).Scan(&user.ID)
+}
+
+func (r *userRepo) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	var user models.User
+	err := r.pool.QueryRow(ctx,
+		`SELECT id, email, username, password, role FROM users WHERE email = $1`, email,
+	).Scan(&user.ID, &user.Email, &user.Username, &user.Password, &user.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepo) FindByID(ctx context.Context, id uint) (*models.User, error) {
+	var user models.User
+	err := r.pool.QueryRow(ctx,
+		`SELECT id, email, username, password, role FROM users WHERE id = $1`, id,
+	).Scan(&user.ID, &user.Email, &user.Username, &user.Password, &user.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
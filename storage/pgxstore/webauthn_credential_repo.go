@@ -0,0 +1,50 @@
+package pgxstore
+
+import (
+	"context"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type webauthnCredentialRepo struct {
+	pool *pgxpool.Pool
+}
+
+func (r *webauthnCredentialRepo) Create(ctx context.Context, credential *models.WebauthnCredential) error {
+	return r.pool.QueryRow(ctx,
+		`INSERT INTO webauthn_credentials (user_id, credential_id, public_key, sign_count, aaguid, transports)
+		 VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		credential.UserID, credential.CredentialID, credential.PublicKey, credential.SignCount,
+		credential.AAGUID, credential.Transports,
+	).Scan(&credential.ID)
+}
+
+func (r *webauthnCredentialRepo) ListByUser(ctx context.Context, userID uint) ([]models.WebauthnCredential, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT id, user_id, credential_id, public_key, sign_count, aaguid, transports
+		 FROM webauthn_credentials WHERE user_id = $1`, userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var credentials []models.WebauthnCredential
+	for rows.Next() {
+		var c models.WebauthnCredential
+		if err := rows.Scan(&c.ID, &c.UserID, &c.CredentialID, &c.PublicKey, &c.SignCount, &c.AAGUID, &c.Transports); err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, c)
+	}
+	return credentials, rows.Err()
+}
+
+func (r *webauthnCredentialRepo) UpdateSignCount(ctx context.Context, credentialID string, signCount uint32) error {
+	_, err := r.pool.Exec(ctx,
+		`UPDATE webauthn_credentials SET sign_count = $1 WHERE credential_id = $2`,
+		signCount, credentialID,
+	)
+	return err
+}
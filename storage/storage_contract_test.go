@@ -0,0 +1,297 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/Domains18/SchoolIt/storage"
+	"github.com/Domains18/SchoolIt/storage/gormstore"
+	"github.com/Domains18/SchoolIt/storage/mongostore"
+	"github.com/Domains18/SchoolIt/storage/pgxstore"
+)
+
+// TestGormStoreContract, TestPgxStoreContract, and TestMongoStoreContract
+// all run runContract — every repository's contract, not just UserRepo's —
+// against a real backend reached via env-configured connection settings, so
+// the same assertions hold regardless of which storage.Store the process is
+// configured to use. Each is skipped if its connection setting isn't set,
+// since none of these backends are available in this repo's CI sandbox.
+
+func TestGormStoreContract(t *testing.T) {
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("TEST_MYSQL_DSN not set")
+	}
+
+	store, err := gormstore.New(gormstore.Config{DSN: dsn})
+	if err != nil {
+		t.Fatalf("gormstore.New: %v", err)
+	}
+	defer store.Close()
+
+	runContract(t, store)
+}
+
+func TestPgxStoreContract(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+
+	store, err := pgxstore.New(pgxstore.Config{DSN: dsn})
+	if err != nil {
+		t.Fatalf("pgxstore.New: %v", err)
+	}
+	defer store.Close()
+
+	runContract(t, store)
+}
+
+func TestMongoStoreContract(t *testing.T) {
+	uri := os.Getenv("TEST_MONGO_URI")
+	db := os.Getenv("TEST_MONGO_DB")
+	if uri == "" || db == "" {
+		t.Skip("TEST_MONGO_URI/TEST_MONGO_DB not set")
+	}
+
+	store, err := mongostore.New(mongostore.Config{URI: uri, Database: db})
+	if err != nil {
+		t.Fatalf("mongostore.New: %v", err)
+	}
+	defer store.Close()
+
+	runContract(t, store)
+}
+
+// runContract runs every repository contract against store, so the same
+// assertions hold regardless of which backend it's backed by.
+func runContract(t *testing.T, store storage.Store) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := store.Migrate(ctx); err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+
+	user := userRepoContract(t, ctx, store)
+	refreshTokenRepoContract(t, ctx, store, user.ID)
+	revokedTokenRepoContract(t, ctx, store)
+	webauthnCredentialRepoContract(t, ctx, store, user.ID)
+	oauthIdentityRepoContract(t, ctx, store, user.ID)
+	noticeRepoContract(t, ctx, store)
+	complaintRepoContract(t, ctx, store, user.ID)
+}
+
+// userRepoContract is the shared behavior every storage.UserRepo must
+// satisfy, independent of which engine backs it. It returns the created user
+// so the other contracts below can hang their records off a real UserID.
+func userRepoContract(t *testing.T, ctx context.Context, store storage.Store) *models.User {
+	t.Helper()
+
+	users := store.Users()
+	email := "contract-test+" + randomSuffix() + "@example.com"
+
+	user := &models.User{Email: email, Username: "contract-tester", Password: "hashed", Role: "student"}
+	if err := users.Create(ctx, user); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if user.ID == 0 {
+		t.Fatal("Create did not populate the new user's ID")
+	}
+
+	byEmail, err := users.FindByEmail(ctx, email)
+	if err != nil {
+		t.Fatalf("FindByEmail: %v", err)
+	}
+	if byEmail.ID != user.ID || byEmail.Username != user.Username {
+		t.Fatalf("FindByEmail returned %+v, want match for %+v", byEmail, user)
+	}
+
+	byID, err := users.FindByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if byID.Email != email {
+		t.Fatalf("FindByID returned %+v, want email %q", byID, email)
+	}
+
+	if _, err := users.FindByEmail(ctx, "does-not-exist+"+randomSuffix()+"@example.com"); err == nil {
+		t.Fatal("FindByEmail with an unknown email should return an error")
+	}
+
+	return user
+}
+
+// refreshTokenRepoContract is the shared behavior every
+// storage.RefreshTokenRepo must satisfy.
+func refreshTokenRepoContract(t *testing.T, ctx context.Context, store storage.Store, userID uint) {
+	t.Helper()
+
+	tokens := store.RefreshTokens()
+	hash := "hash-" + randomSuffix()
+
+	token := &models.RefreshToken{UserID: userID, TokenHash: hash, ExpiresAt: time.Now().Add(time.Hour)}
+	if err := tokens.Create(ctx, token); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	found, err := tokens.FindByHash(ctx, hash)
+	if err != nil {
+		t.Fatalf("FindByHash: %v", err)
+	}
+	if found.UserID != userID {
+		t.Fatalf("FindByHash returned %+v, want UserID %d", found, userID)
+	}
+
+	if err := tokens.Revoke(ctx, hash, time.Now()); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+	revoked, err := tokens.FindByHash(ctx, hash)
+	if err != nil {
+		t.Fatalf("FindByHash after Revoke: %v", err)
+	}
+	if revoked.RevokedAt == nil {
+		t.Fatal("Revoke did not set RevokedAt")
+	}
+
+	if err := tokens.RevokeAllForUser(ctx, userID, time.Now()); err != nil {
+		t.Fatalf("RevokeAllForUser: %v", err)
+	}
+}
+
+// revokedTokenRepoContract is the shared behavior every
+// storage.RevokedTokenRepo must satisfy.
+func revokedTokenRepoContract(t *testing.T, ctx context.Context, store storage.Store) {
+	t.Helper()
+
+	revoked := store.RevokedTokens()
+	jti := "jti-" + randomSuffix()
+
+	if is, err := revoked.IsRevoked(ctx, jti); err != nil {
+		t.Fatalf("IsRevoked before Create: %v", err)
+	} else if is {
+		t.Fatal("IsRevoked reported a jti that was never created as revoked")
+	}
+
+	if err := revoked.Create(ctx, &models.RevokedToken{JTI: jti, ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if is, err := revoked.IsRevoked(ctx, jti); err != nil {
+		t.Fatalf("IsRevoked after Create: %v", err)
+	} else if !is {
+		t.Fatal("IsRevoked did not report a created jti as revoked")
+	}
+}
+
+// webauthnCredentialRepoContract is the shared behavior every
+// storage.WebauthnCredentialRepo must satisfy.
+func webauthnCredentialRepoContract(t *testing.T, ctx context.Context, store storage.Store, userID uint) {
+	t.Helper()
+
+	credentials := store.WebauthnCredentials()
+	credentialID := "cred-" + randomSuffix()
+
+	credential := &models.WebauthnCredential{UserID: userID, CredentialID: credentialID, PublicKey: []byte("public-key")}
+	if err := credentials.Create(ctx, credential); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	list, err := credentials.ListByUser(ctx, userID)
+	if err != nil {
+		t.Fatalf("ListByUser: %v", err)
+	}
+	if len(list) != 1 || list[0].CredentialID != credentialID {
+		t.Fatalf("ListByUser returned %+v, want a single credential %q", list, credentialID)
+	}
+
+	if err := credentials.UpdateSignCount(ctx, credentialID, 7); err != nil {
+		t.Fatalf("UpdateSignCount: %v", err)
+	}
+	list, err = credentials.ListByUser(ctx, userID)
+	if err != nil {
+		t.Fatalf("ListByUser after UpdateSignCount: %v", err)
+	}
+	if list[0].SignCount != 7 {
+		t.Fatalf("UpdateSignCount did not persist, got SignCount %d", list[0].SignCount)
+	}
+}
+
+// oauthIdentityRepoContract is the shared behavior every
+// storage.OAuthIdentityRepo must satisfy.
+func oauthIdentityRepoContract(t *testing.T, ctx context.Context, store storage.Store, userID uint) {
+	t.Helper()
+
+	identities := store.OAuthIdentities()
+	providerUserID := "provider-user-" + randomSuffix()
+
+	identity := &models.OAuthIdentity{UserID: userID, Provider: "google", ProviderUserID: providerUserID, Email: "contract@example.com"}
+	if err := identities.Create(ctx, identity); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	found, err := identities.FindByProvider(ctx, "google", providerUserID)
+	if err != nil {
+		t.Fatalf("FindByProvider: %v", err)
+	}
+	if found.UserID != userID {
+		t.Fatalf("FindByProvider returned %+v, want UserID %d", found, userID)
+	}
+
+	if _, err := identities.FindByProvider(ctx, "google", "does-not-exist-"+randomSuffix()); err == nil {
+		t.Fatal("FindByProvider with an unknown provider user id should return an error")
+	}
+}
+
+// noticeRepoContract is the shared behavior every storage.NoticeRepo must
+// satisfy.
+func noticeRepoContract(t *testing.T, ctx context.Context, store storage.Store) {
+	t.Helper()
+
+	notices := store.Notices()
+	school := "contract-school-" + randomSuffix()
+
+	notice := &storage.Notice{Title: "Contract Notice", Details: "details", Date: time.Now(), School: school}
+	if err := notices.Create(ctx, notice); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	list, err := notices.ListBySchool(ctx, school)
+	if err != nil {
+		t.Fatalf("ListBySchool: %v", err)
+	}
+	if len(list) != 1 || list[0].Title != notice.Title {
+		t.Fatalf("ListBySchool returned %+v, want a single notice %q", list, notice.Title)
+	}
+}
+
+// complaintRepoContract is the shared behavior every storage.ComplaintRepo
+// must satisfy.
+func complaintRepoContract(t *testing.T, ctx context.Context, store storage.Store, userID uint) {
+	t.Helper()
+
+	complaints := store.Complaints()
+	school := "contract-school-" + randomSuffix()
+
+	complaint := &storage.Complaint{UserID: userID, Date: time.Now(), Complaint: "contract complaint", School: school}
+	if err := complaints.Create(ctx, complaint); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	list, err := complaints.ListBySchool(ctx, school)
+	if err != nil {
+		t.Fatalf("ListBySchool: %v", err)
+	}
+	if len(list) != 1 || list[0].Complaint != complaint.Complaint {
+		t.Fatalf("ListBySchool returned %+v, want a single complaint %q", list, complaint.Complaint)
+	}
+}
+
+func randomSuffix() string {
+	return time.Now().Format("20060102150405.000000000")
+}
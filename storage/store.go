@@ -0,0 +1,119 @@
+// Package storage defines the repository-shaped contract the rest of the
+// app talks to, so controllers never import GORM, pgx, or the Mongo driver
+// directly. Three backends (gormstore, pgxstore, mongostore) each implement
+// Store; the process picks one at startup based on STORAGE_DRIVER.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domains18/SchoolIt/models"
+)
+
+// UserRepo persists the local user accounts used for password and
+// federated login.
+type UserRepo interface {
+	Create(ctx context.Context, user *models.User) error
+	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	FindByID(ctx context.Context, id uint) (*models.User, error)
+}
+
+// RefreshTokenRepo persists the rotating refresh tokens issued alongside an
+// access JWT, keyed by the SHA-256 hash of the opaque token (the cleartext
+// value is never stored).
+type RefreshTokenRepo interface {
+	Create(ctx context.Context, token *models.RefreshToken) error
+	FindByHash(ctx context.Context, hash string) (*models.RefreshToken, error)
+	// Revoke marks the token with the given hash as revoked at revokedAt,
+	// if it isn't already.
+	Revoke(ctx context.Context, hash string, revokedAt time.Time) error
+	// RevokeAllForUser revokes every outstanding token for userID, e.g.
+	// after a password change.
+	RevokeAllForUser(ctx context.Context, userID uint, revokedAt time.Time) error
+}
+
+// RevokedTokenRepo blacklists the jti of an access token that was
+// invalidated before it expired naturally (logout, password change,
+// compromise).
+type RevokedTokenRepo interface {
+	Create(ctx context.Context, token *models.RevokedToken) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// WebauthnCredentialRepo persists the passkeys registered for a user.
+type WebauthnCredentialRepo interface {
+	Create(ctx context.Context, credential *models.WebauthnCredential) error
+	ListByUser(ctx context.Context, userID uint) ([]models.WebauthnCredential, error)
+	UpdateSignCount(ctx context.Context, credentialID string, signCount uint32) error
+}
+
+// OAuthIdentityRepo persists the link between a federated login and a local
+// User.
+type OAuthIdentityRepo interface {
+	Create(ctx context.Context, identity *models.OAuthIdentity) error
+	FindByProvider(ctx context.Context, provider, providerUserID string) (*models.OAuthIdentity, error)
+}
+
+// Notice is the engine-agnostic shape of a school notice. ID is an opaque
+// string so callers don't need to care whether the backing store assigns
+// auto-increment integers or ObjectIDs.
+type Notice struct {
+	ID      string
+	Title   string
+	Details string
+	Date    time.Time
+	School  string
+}
+
+// NoticeRepo persists school notices.
+type NoticeRepo interface {
+	Create(ctx context.Context, notice *Notice) error
+	ListBySchool(ctx context.Context, school string) ([]Notice, error)
+}
+
+// Complaint is the engine-agnostic shape of a student complaint.
+type Complaint struct {
+	ID        string
+	UserID    uint
+	Date      time.Time
+	Complaint string
+	School    string
+}
+
+// ComplaintRepo persists student complaints.
+type ComplaintRepo interface {
+	Create(ctx context.Context, complaint *Complaint) error
+	ListBySchool(ctx context.Context, school string) ([]Complaint, error)
+}
+
+// Store is the single entry point the rest of the app uses to reach
+// persistence, regardless of which engine is backing it.
+type Store interface {
+	Users() UserRepo
+	Notices() NoticeRepo
+	Complaints() ComplaintRepo
+	RefreshTokens() RefreshTokenRepo
+	RevokedTokens() RevokedTokenRepo
+	WebauthnCredentials() WebauthnCredentialRepo
+	OAuthIdentities() OAuthIdentityRepo
+
+	// Migrate brings the SQL schema up to date. For Mongo it is a no-op:
+	// collections are created on first insert and no indexes are created.
+	Migrate(ctx context.Context) error
+
+	// Close releases the underlying connection/pool/client.
+	Close() error
+}
+
+// Config configures whichever backend Driver selects. DSN is interpreted by
+// gormstore/pgxstore as a SQL connection string; Mongo uses MongoURI and
+// MongoDB instead.
+type Config struct {
+	Driver   string
+	DSN      string
+	MaxOpen  int
+	MaxIdle  int
+	MongoURI string
+	MongoDB  string
+}
@@ -0,0 +1,165 @@
+// Package webauthn wraps github.com/go-webauthn/webauthn so the rest of the
+// app only deals with emails and our own models, never the library's
+// ceremony types directly.
+package webauthn
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/Domains18/SchoolIt/models"
+	"github.com/Domains18/SchoolIt/storage"
+	"github.com/go-webauthn/webauthn/protocol"
+	gowebauthn "github.com/go-webauthn/webauthn/webauthn"
+)
+
+// instance is the process-wide relying-party configuration, set once by
+// Init at startup.
+var instance *gowebauthn.WebAuthn
+
+// store is the storage.Store this package persists users and credentials
+// through, set once by Init at startup.
+var store storage.Store
+
+// Config describes the relying party this server presents itself as.
+type Config struct {
+	RPDisplayName string
+	RPID          string
+	RPOrigins     []string
+}
+
+// Init builds the package-level WebAuthn instance and wires it to s, the
+// storage.Store ceremonies read users and credentials from. It must be
+// called once during startup before any ceremony helper is used.
+func Init(cfg Config, s storage.Store) error {
+	built, err := gowebauthn.New(&gowebauthn.Config{
+		RPDisplayName: cfg.RPDisplayName,
+		RPID:          cfg.RPID,
+		RPOrigins:     cfg.RPOrigins,
+	})
+	if err != nil {
+		return err
+	}
+	instance = built
+	store = s
+	return nil
+}
+
+// webauthnUser adapts a models.User plus its already-loaded credentials to
+// the go-webauthn/webauthn.User interface.
+type webauthnUser struct {
+	user        models.User
+	credentials []gowebauthn.Credential
+}
+
+func (u webauthnUser) WebAuthnID() []byte                           { return u.user.WebauthnID() }
+func (u webauthnUser) WebAuthnName() string                         { return u.user.Username }
+func (u webauthnUser) WebAuthnDisplayName() string                  { return u.user.Username }
+func (u webauthnUser) WebAuthnCredentials() []gowebauthn.Credential { return u.credentials }
+func (u webauthnUser) WebAuthnIcon() string                         { return "" }
+
+// BeginRegistration starts a passkey registration ceremony for the user
+// identified by email. The returned SessionData must be stashed (e.g. via
+// sessions.Store) and handed back unchanged to FinishRegistration.
+func BeginRegistration(email string) (*protocol.CredentialCreation, *gowebauthn.SessionData, error) {
+	user, err := loadUser(email)
+	if err != nil {
+		return nil, nil, err
+	}
+	return instance.BeginRegistration(user)
+}
+
+// FinishRegistration validates the authenticator's response in r against
+// session and, on success, persists the new credential.
+func FinishRegistration(email string, session gowebauthn.SessionData, r *http.Request) error {
+	user, err := loadUser(email)
+	if err != nil {
+		return err
+	}
+
+	credential, err := instance.FinishRegistration(user, session, r)
+	if err != nil {
+		return err
+	}
+
+	return store.WebauthnCredentials().Create(context.Background(), &models.WebauthnCredential{
+		UserID:       user.user.ID,
+		CredentialID: base64.RawURLEncoding.EncodeToString(credential.ID),
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.Authenticator.SignCount,
+		AAGUID:       base64.RawURLEncoding.EncodeToString(credential.Authenticator.AAGUID),
+	})
+}
+
+// BeginLogin starts a passkey login ceremony for the user identified by
+// email. The returned SessionData must be stashed and handed back unchanged
+// to FinishLogin.
+func BeginLogin(email string) (*protocol.CredentialAssertion, *gowebauthn.SessionData, error) {
+	user, err := loadUser(email)
+	if err != nil {
+		return nil, nil, err
+	}
+	return instance.BeginLogin(user)
+}
+
+// FinishLogin validates the authenticator's assertion in r against session
+// and, on success, persists the updated signature counter back to the stored
+// credential and returns the user it proved possession for.
+func FinishLogin(email string, session gowebauthn.SessionData, r *http.Request) (models.User, error) {
+	user, err := loadUser(email)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	credential, err := instance.FinishLogin(user, session, r)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	err = store.WebauthnCredentials().UpdateSignCount(
+		context.Background(),
+		base64.RawURLEncoding.EncodeToString(credential.ID),
+		credential.Authenticator.SignCount,
+	)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	return user.user, nil
+}
+
+func loadUser(email string) (webauthnUser, error) {
+	ctx := context.Background()
+	user, err := store.Users().FindByEmail(ctx, email)
+	if err != nil {
+		return webauthnUser{}, err
+	}
+
+	rows, err := store.WebauthnCredentials().ListByUser(ctx, user.ID)
+	if err != nil {
+		return webauthnUser{}, err
+	}
+
+	credentials := make([]gowebauthn.Credential, 0, len(rows))
+	for _, row := range rows {
+		credentialID, err := base64.RawURLEncoding.DecodeString(row.CredentialID)
+		if err != nil {
+			return webauthnUser{}, err
+		}
+		aaguid, err := base64.RawURLEncoding.DecodeString(row.AAGUID)
+		if err != nil {
+			return webauthnUser{}, err
+		}
+		credentials = append(credentials, gowebauthn.Credential{
+			ID:        credentialID,
+			PublicKey: row.PublicKey,
+			Authenticator: gowebauthn.Authenticator{
+				AAGUID:    aaguid,
+				SignCount: row.SignCount,
+			},
+		})
+	}
+
+	return webauthnUser{user: *user, credentials: credentials}, nil
+}